@@ -0,0 +1,100 @@
+// Package memory provides an in-memory adapter implementing the player
+// domain's QueueRepository and PreferencesRepository ports, handy for tests
+// and local development.
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/domain"
+)
+
+// Adapter is an in-memory domain.QueueRepository and
+// domain.PreferencesRepository.
+type Adapter struct {
+	mu sync.Mutex
+
+	queues          map[string]*domain.Queue
+	playNextEnabled map[string]bool
+	related         map[domain.VideoID][]domain.VideoID
+	personalized    map[string][]domain.VideoID
+}
+
+// New returns an empty Adapter.
+func New() *Adapter {
+	return &Adapter{
+		queues:          make(map[string]*domain.Queue),
+		playNextEnabled: make(map[string]bool),
+		related:         make(map[domain.VideoID][]domain.VideoID),
+		personalized:    make(map[string][]domain.VideoID),
+	}
+}
+
+func (a *Adapter) FetchQueueFor(ctx context.Context, viewerID string) (*domain.Queue, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	queue, ok := a.queues[viewerID]
+	if !ok {
+		queue = domain.NewQueue()
+		a.queues[viewerID] = queue
+	}
+
+	return queue, nil
+}
+
+func (a *Adapter) SaveQueue(ctx context.Context, viewerID string, queue *domain.Queue) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.queues[viewerID] = queue
+
+	return nil
+}
+
+func (a *Adapter) IsPlayNextEnabled(ctx context.Context, viewerID string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.playNextEnabled[viewerID]
+}
+
+// SetPlayNextEnabled configures whether autoplay is enabled for a viewer.
+func (a *Adapter) SetPlayNextEnabled(viewerID string, enabled bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.playNextEnabled[viewerID] = enabled
+}
+
+func (a *Adapter) RelatedVideos(ctx context.Context, videoID domain.VideoID) []domain.VideoID {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.related[videoID]
+}
+
+// SetRelatedVideos configures the videos considered related to videoID.
+func (a *Adapter) SetRelatedVideos(videoID domain.VideoID, related []domain.VideoID) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.related[videoID] = related
+}
+
+func (a *Adapter) PersonalizedQueue(ctx context.Context, viewerID string) []domain.VideoID {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.personalized[viewerID]
+}
+
+// SetPersonalizedQueue configures the ranked videos recommended for a
+// viewer.
+func (a *Adapter) SetPersonalizedQueue(viewerID string, ranked []domain.VideoID) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.personalized[viewerID] = ranked
+}
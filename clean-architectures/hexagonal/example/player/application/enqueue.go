@@ -0,0 +1,36 @@
+package application
+
+import (
+	"context"
+
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/domain"
+	"github.com/thisiserico/preferences/request"
+)
+
+type Enqueue func(ctx context.Context, videoID string) error
+
+// EnqueueUseCase appends a video to the queue owned by the caller
+// identified in ctx.
+func EnqueueUseCase(queues domain.QueueRepository) Enqueue {
+	return func(ctx context.Context, videoID string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		id, err := domain.VideoIDFrom(videoID)
+		if err != nil {
+			return err
+		}
+
+		viewerID, _ := request.IdentityFrom(ctx)
+
+		queue, err := queues.FetchQueueFor(ctx, viewerID)
+		if err != nil {
+			return err
+		}
+
+		queue.Enqueue(id)
+
+		return queues.SaveQueue(ctx, viewerID, queue)
+	}
+}
@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/adapter/memory"
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/domain"
+	"github.com/thisiserico/preferences/request"
+)
+
+func TestEnqueueUseCase(t *testing.T) {
+	store := memory.New()
+	enqueue := EnqueueUseCase(store)
+
+	ctx := request.WithIdentity(context.Background(), "viewer-1")
+
+	if err := enqueue(ctx, "a"); err != nil {
+		t.Fatalf("no error was expected, got %#v", err)
+	}
+
+	queue, err := store.FetchQueueFor(ctx, "viewer-1")
+	if err != nil {
+		t.Fatalf("no error was expected, got %#v", err)
+	}
+
+	if got := queue.Skip(); got != domain.VideoID("a") {
+		t.Fatalf("expected the enqueued video to be played next, got %#v", got)
+	}
+}
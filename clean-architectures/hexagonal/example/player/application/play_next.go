@@ -1,20 +1,47 @@
 package application
 
-import "github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/domain"
+import (
+	"context"
 
-type PlayNext func(currentlyPlaying string) (domain.VideoID, error)
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/domain"
+	"github.com/thisiserico/preferences/request"
+)
+
+type PlayNext func(ctx context.Context, currentlyPlaying string) (domain.VideoID, error)
+
+// PlayNextUseCase returns the next video to play for the queue owned by the
+// caller identified in ctx: the next explicitly enqueued video if there is
+// one, otherwise whatever the given AutoplayPolicy decides.
+func PlayNextUseCase(queues domain.QueueRepository, prefs domain.PreferencesRepository, policy domain.AutoplayPolicy) PlayNext {
+	return func(ctx context.Context, currentlyPlaying string) (domain.VideoID, error) {
+		if err := ctx.Err(); err != nil {
+			return domain.NoVideo, err
+		}
 
-func PlayNextUseCase(store domain.Store) PlayNext {
-	return func(currentlyPlaying string) (domain.VideoID, error) {
 		videoID, err := domain.VideoIDFrom(currentlyPlaying)
 		if err != nil {
 			return videoID, err
 		}
 
-		if !store.IsPlayNextEnabled() {
+		viewerID, _ := request.IdentityFrom(ctx)
+
+		if !prefs.IsPlayNextEnabled(ctx, viewerID) {
 			return domain.NoVideo, nil
 		}
 
-		return store.NextAfter(videoID), nil
+		queue, err := queues.FetchQueueFor(ctx, viewerID)
+		if err != nil {
+			return domain.NoVideo, err
+		}
+
+		if next := queue.Skip(); next != domain.NoVideo {
+			if err := queues.SaveQueue(ctx, viewerID, queue); err != nil {
+				return domain.NoVideo, err
+			}
+
+			return next, nil
+		}
+
+		return policy.NextAfter(ctx, viewerID, videoID, prefs), nil
 	}
 }
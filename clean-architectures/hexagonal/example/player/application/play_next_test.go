@@ -0,0 +1,115 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/adapter/memory"
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/domain"
+	"github.com/thisiserico/preferences/request"
+)
+
+func TestPlayNextUseCase(t *testing.T) {
+	t.Run("errors when the context is already canceled", func(t *testing.T) {
+		store := memory.New()
+		policy := domain.LinearAutoplay{}
+		playNext := PlayNextUseCase(store, store, policy)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if _, err := playNext(ctx, "current"); err != context.Canceled {
+			t.Fatalf("a context.Canceled error was expected, got %#v", err)
+		}
+	})
+
+	t.Run("propagates the context deadline down to the repositories", func(t *testing.T) {
+		store := recordingAdapter{Adapter: memory.New()}
+		store.SetPlayNextEnabled("viewer-1", true)
+		policy := domain.LinearAutoplay{}
+		playNext := PlayNextUseCase(&store, &store, policy)
+
+		deadline := time.Now().Add(time.Minute)
+		ctx, cancel := context.WithDeadline(request.WithIdentity(context.Background(), "viewer-1"), deadline)
+		defer cancel()
+
+		if _, err := playNext(ctx, "current"); err != nil {
+			t.Fatalf("no error was expected, got %#v", err)
+		}
+
+		if !store.sawDeadline || !store.receivedDeadline.Equal(deadline) {
+			t.Fatalf("the repository did not receive the expected deadline, got %v", store.receivedDeadline)
+		}
+	})
+
+	t.Run("returns no video when play next is disabled", func(t *testing.T) {
+		store := memory.New()
+		policy := domain.LinearAutoplay{}
+		playNext := PlayNextUseCase(store, store, policy)
+
+		ctx := request.WithIdentity(context.Background(), "viewer-1")
+
+		videoID, err := playNext(ctx, "current")
+		if err != nil {
+			t.Fatalf("no error was expected, got %#v", err)
+		}
+
+		if videoID != domain.NoVideo {
+			t.Fatalf("no video was expected, got %#v", videoID)
+		}
+	})
+
+	t.Run("plays the next explicitly enqueued video before consulting the autoplay policy", func(t *testing.T) {
+		store := memory.New()
+		store.SetPlayNextEnabled("viewer-1", true)
+
+		ctx := request.WithIdentity(context.Background(), "viewer-1")
+		queue, _ := store.FetchQueueFor(ctx, "viewer-1")
+		queue.Enqueue(domain.VideoID("queued"))
+		store.SaveQueue(ctx, "viewer-1", queue)
+
+		policy := domain.LinearAutoplay{Catalog: []domain.VideoID{"current", "catalog-next"}}
+		playNext := PlayNextUseCase(store, store, policy)
+
+		videoID, err := playNext(ctx, "current")
+		if err != nil {
+			t.Fatalf("no error was expected, got %#v", err)
+		}
+
+		if videoID != domain.VideoID("queued") {
+			t.Fatalf("the queued video was expected, got %#v", videoID)
+		}
+	})
+
+	t.Run("falls back to the autoplay policy once the queue is exhausted", func(t *testing.T) {
+		store := memory.New()
+		store.SetPlayNextEnabled("viewer-1", true)
+
+		ctx := request.WithIdentity(context.Background(), "viewer-1")
+		policy := domain.LinearAutoplay{Catalog: []domain.VideoID{"current", "catalog-next"}}
+		playNext := PlayNextUseCase(store, store, policy)
+
+		videoID, err := playNext(ctx, "current")
+		if err != nil {
+			t.Fatalf("no error was expected, got %#v", err)
+		}
+
+		if videoID != domain.VideoID("catalog-next") {
+			t.Fatalf("the catalog's next video was expected, got %#v", videoID)
+		}
+	})
+}
+
+type recordingAdapter struct {
+	*memory.Adapter
+
+	receivedDeadline time.Time
+	sawDeadline      bool
+}
+
+func (a *recordingAdapter) IsPlayNextEnabled(ctx context.Context, viewerID string) bool {
+	a.receivedDeadline, a.sawDeadline = ctx.Deadline()
+
+	return a.Adapter.IsPlayNextEnabled(ctx, viewerID)
+}
@@ -0,0 +1,31 @@
+package application
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/domain"
+	"github.com/thisiserico/preferences/request"
+)
+
+type Shuffle func(ctx context.Context) error
+
+// ShuffleUseCase randomizes the order of the caller's queue using rng.
+func ShuffleUseCase(queues domain.QueueRepository, rng *rand.Rand) Shuffle {
+	return func(ctx context.Context) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		viewerID, _ := request.IdentityFrom(ctx)
+
+		queue, err := queues.FetchQueueFor(ctx, viewerID)
+		if err != nil {
+			return err
+		}
+
+		queue.Shuffle(rng)
+
+		return queues.SaveQueue(ctx, viewerID, queue)
+	}
+}
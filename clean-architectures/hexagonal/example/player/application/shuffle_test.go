@@ -0,0 +1,39 @@
+package application
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/adapter/memory"
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/domain"
+	"github.com/thisiserico/preferences/request"
+)
+
+func TestShuffleUseCase(t *testing.T) {
+	store := memory.New()
+
+	ctx := request.WithIdentity(context.Background(), "viewer-1")
+	queue, _ := store.FetchQueueFor(ctx, "viewer-1")
+	queue.Enqueue(domain.VideoID("a"))
+	queue.Enqueue(domain.VideoID("b"))
+	queue.Enqueue(domain.VideoID("c"))
+	if err := store.SaveQueue(ctx, "viewer-1", queue); err != nil {
+		t.Fatalf("no error was expected, got %#v", err)
+	}
+
+	shuffle := ShuffleUseCase(store, rand.New(rand.NewSource(1)))
+
+	if err := shuffle(ctx); err != nil {
+		t.Fatalf("no error was expected, got %#v", err)
+	}
+
+	shuffled, err := store.FetchQueueFor(ctx, "viewer-1")
+	if err != nil {
+		t.Fatalf("no error was expected, got %#v", err)
+	}
+
+	if shuffled.Current() != domain.NoVideo {
+		t.Fatalf("shuffling was expected to reset the cursor, got %q", shuffled.Current())
+	}
+}
@@ -0,0 +1,35 @@
+package application
+
+import (
+	"context"
+
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/domain"
+	"github.com/thisiserico/preferences/request"
+)
+
+type Skip func(ctx context.Context) (domain.VideoID, error)
+
+// SkipUseCase advances the caller's queue and returns the video it lands
+// on, or domain.NoVideo when the queue is exhausted.
+func SkipUseCase(queues domain.QueueRepository) Skip {
+	return func(ctx context.Context) (domain.VideoID, error) {
+		if err := ctx.Err(); err != nil {
+			return domain.NoVideo, err
+		}
+
+		viewerID, _ := request.IdentityFrom(ctx)
+
+		queue, err := queues.FetchQueueFor(ctx, viewerID)
+		if err != nil {
+			return domain.NoVideo, err
+		}
+
+		next := queue.Skip()
+
+		if err := queues.SaveQueue(ctx, viewerID, queue); err != nil {
+			return domain.NoVideo, err
+		}
+
+		return next, nil
+	}
+}
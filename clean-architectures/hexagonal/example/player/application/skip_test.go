@@ -0,0 +1,33 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/adapter/memory"
+	"github.com/thisiserico/preferences/clean-architectures/hexagonal/example/player/domain"
+	"github.com/thisiserico/preferences/request"
+)
+
+func TestSkipUseCase(t *testing.T) {
+	store := memory.New()
+
+	ctx := request.WithIdentity(context.Background(), "viewer-1")
+	queue, _ := store.FetchQueueFor(ctx, "viewer-1")
+	queue.Enqueue(domain.VideoID("a"))
+	queue.Enqueue(domain.VideoID("b"))
+	if err := store.SaveQueue(ctx, "viewer-1", queue); err != nil {
+		t.Fatalf("no error was expected, got %#v", err)
+	}
+
+	skip := SkipUseCase(store)
+
+	got, err := skip(ctx)
+	if err != nil {
+		t.Fatalf("no error was expected, got %#v", err)
+	}
+
+	if got != domain.VideoID("a") {
+		t.Fatalf("expected %q, got %q", "a", got)
+	}
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"context"
+	"math/rand"
+)
+
+// AutoplayPolicy decides which video to play once a viewer's Queue runs out
+// of explicitly enqueued items.
+type AutoplayPolicy interface {
+	NextAfter(ctx context.Context, viewerID string, currentlyPlaying VideoID, prefs PreferencesRepository) VideoID
+}
+
+// LinearAutoplay plays whatever follows currentlyPlaying in Catalog.
+type LinearAutoplay struct {
+	Catalog []VideoID
+}
+
+func (p LinearAutoplay) NextAfter(ctx context.Context, viewerID string, currentlyPlaying VideoID, prefs PreferencesRepository) VideoID {
+	for i, id := range p.Catalog {
+		if id == currentlyPlaying && i+1 < len(p.Catalog) {
+			return p.Catalog[i+1]
+		}
+	}
+
+	return NoVideo
+}
+
+// ShuffleAutoplay plays a random video from Catalog, other than the one
+// currently playing.
+type ShuffleAutoplay struct {
+	Catalog []VideoID
+	Rand    *rand.Rand
+}
+
+func (p ShuffleAutoplay) NextAfter(ctx context.Context, viewerID string, currentlyPlaying VideoID, prefs PreferencesRepository) VideoID {
+	candidates := make([]VideoID, 0, len(p.Catalog))
+	for _, id := range p.Catalog {
+		if id != currentlyPlaying {
+			candidates = append(candidates, id)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return NoVideo
+	}
+
+	return candidates[p.Rand.Intn(len(candidates))]
+}
+
+// RelatedVideosAutoplay plays the first video PreferencesRepository
+// considers related to the one currently playing.
+type RelatedVideosAutoplay struct{}
+
+func (p RelatedVideosAutoplay) NextAfter(ctx context.Context, viewerID string, currentlyPlaying VideoID, prefs PreferencesRepository) VideoID {
+	related := prefs.RelatedVideos(ctx, currentlyPlaying)
+	if len(related) == 0 {
+		return NoVideo
+	}
+
+	return related[0]
+}
+
+// PersonalizedAutoplay plays the highest-ranked video from the viewer's
+// PersonalizedQueue that isn't the one currently playing.
+type PersonalizedAutoplay struct{}
+
+func (p PersonalizedAutoplay) NextAfter(ctx context.Context, viewerID string, currentlyPlaying VideoID, prefs PreferencesRepository) VideoID {
+	for _, id := range prefs.PersonalizedQueue(ctx, viewerID) {
+		if id != currentlyPlaying {
+			return id
+		}
+	}
+
+	return NoVideo
+}
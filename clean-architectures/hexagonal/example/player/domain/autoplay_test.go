@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+)
+
+type fakePreferences struct {
+	related      []VideoID
+	personalized []VideoID
+}
+
+func (p fakePreferences) IsPlayNextEnabled(ctx context.Context, viewerID string) bool {
+	return true
+}
+
+func (p fakePreferences) RelatedVideos(ctx context.Context, videoID VideoID) []VideoID {
+	return p.related
+}
+
+func (p fakePreferences) PersonalizedQueue(ctx context.Context, viewerID string) []VideoID {
+	return p.personalized
+}
+
+func TestAutoplayPolicies(t *testing.T) {
+	tests := map[string]struct {
+		policy           AutoplayPolicy
+		prefs            fakePreferences
+		currentlyPlaying VideoID
+		expected         VideoID
+	}{
+		"linear plays the next video in the catalog": {
+			policy:           LinearAutoplay{Catalog: []VideoID{"a", "b", "c"}},
+			currentlyPlaying: "b",
+			expected:         "c",
+		},
+		"linear has nothing to play after the last video": {
+			policy:           LinearAutoplay{Catalog: []VideoID{"a", "b"}},
+			currentlyPlaying: "b",
+			expected:         NoVideo,
+		},
+		"linear has nothing to play when the catalog is empty": {
+			policy:           LinearAutoplay{},
+			currentlyPlaying: "a",
+			expected:         NoVideo,
+		},
+		"shuffle plays a catalog video other than the current one": {
+			policy:           ShuffleAutoplay{Catalog: []VideoID{"a", "b"}, Rand: rand.New(rand.NewSource(1))},
+			currentlyPlaying: "a",
+			expected:         "b",
+		},
+		"shuffle has nothing to play when no other video exists": {
+			policy:           ShuffleAutoplay{Catalog: []VideoID{"a"}, Rand: rand.New(rand.NewSource(1))},
+			currentlyPlaying: "a",
+			expected:         NoVideo,
+		},
+		"related-videos plays the first related video": {
+			policy:           RelatedVideosAutoplay{},
+			prefs:            fakePreferences{related: []VideoID{"r1", "r2"}},
+			currentlyPlaying: "a",
+			expected:         "r1",
+		},
+		"related-videos has nothing to play without related videos": {
+			policy:           RelatedVideosAutoplay{},
+			currentlyPlaying: "a",
+			expected:         NoVideo,
+		},
+		"personalized plays the top-ranked video that isn't playing": {
+			policy:           PersonalizedAutoplay{},
+			prefs:            fakePreferences{personalized: []VideoID{"a", "p1", "p2"}},
+			currentlyPlaying: "a",
+			expected:         "p1",
+		},
+		"personalized has nothing to play without a ranking": {
+			policy:           PersonalizedAutoplay{},
+			currentlyPlaying: "a",
+			expected:         NoVideo,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := test.policy.NextAfter(context.Background(), "viewer-1", test.currentlyPlaying, test.prefs)
+
+			if got != test.expected {
+				t.Fatalf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
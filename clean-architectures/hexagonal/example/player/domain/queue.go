@@ -0,0 +1,98 @@
+package domain
+
+import "math/rand"
+
+// RepeatMode controls what a Queue does once its cursor runs past the last
+// enqueued video.
+type RepeatMode int
+
+const (
+	RepeatOff RepeatMode = iota
+	RepeatOne
+	RepeatAll
+)
+
+// Queue is the ordered list of videos a viewer is progressing through.
+type Queue struct {
+	items  []VideoID
+	cursor int
+	repeat RepeatMode
+}
+
+// NewQueue builds a Queue from the given videos, positioned before the
+// first one.
+func NewQueue(items ...VideoID) *Queue {
+	return &Queue{items: items, cursor: -1}
+}
+
+// Enqueue appends a video to the end of the queue.
+func (q *Queue) Enqueue(id VideoID) {
+	q.items = append(q.items, id)
+}
+
+// Current returns the video the cursor currently points to, or NoVideo if
+// the queue hasn't started or has run out.
+func (q *Queue) Current() VideoID {
+	if q.cursor < 0 || q.cursor >= len(q.items) {
+		return NoVideo
+	}
+
+	return q.items[q.cursor]
+}
+
+// Skip advances the cursor and returns the video it lands on. It honors the
+// queue's RepeatMode once the end is reached, returning NoVideo when the
+// queue is exhausted and repeat is off.
+func (q *Queue) Skip() VideoID {
+	if len(q.items) == 0 {
+		return NoVideo
+	}
+
+	q.cursor++
+
+	if q.cursor >= len(q.items) {
+		switch q.repeat {
+		case RepeatAll:
+			q.cursor = 0
+		case RepeatOne:
+			q.cursor--
+		default:
+			q.cursor = len(q.items)
+			return NoVideo
+		}
+	}
+
+	return q.Current()
+}
+
+// Prev moves the cursor back and returns the video it lands on, or NoVideo
+// if there's nothing before the current position.
+func (q *Queue) Prev() VideoID {
+	if q.cursor <= 0 {
+		return NoVideo
+	}
+
+	q.cursor--
+
+	return q.Current()
+}
+
+// Shuffle randomizes the order of every video in the queue, played ones
+// included, using rng, and moves the cursor back before the first one.
+func (q *Queue) Shuffle(rng *rand.Rand) {
+	rng.Shuffle(len(q.items), func(i, j int) {
+		q.items[i], q.items[j] = q.items[j], q.items[i]
+	})
+
+	q.cursor = -1
+}
+
+// Repeat sets the queue's repeat mode.
+func (q *Queue) Repeat(mode RepeatMode) {
+	q.repeat = mode
+}
+
+// IsEmpty reports whether the queue has no videos at all.
+func (q *Queue) IsEmpty() bool {
+	return len(q.items) == 0
+}
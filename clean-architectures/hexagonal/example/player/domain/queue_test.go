@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestQueueSkip(t *testing.T) {
+	tests := map[string]struct {
+		items    []VideoID
+		repeat   RepeatMode
+		skips    int
+		expected VideoID
+	}{
+		"advances through the queue": {
+			items:    []VideoID{"a", "b", "c"},
+			skips:    2,
+			expected: "b",
+		},
+		"returns no video once exhausted with repeat off": {
+			items:    []VideoID{"a", "b"},
+			skips:    3,
+			expected: NoVideo,
+		},
+		"wraps around to the start with repeat all": {
+			items:    []VideoID{"a", "b"},
+			repeat:   RepeatAll,
+			skips:    3,
+			expected: "a",
+		},
+		"stays on the same video with repeat one": {
+			items:    []VideoID{"a", "b"},
+			repeat:   RepeatOne,
+			skips:    3,
+			expected: "b",
+		},
+		"returns no video on an empty queue": {
+			items:    nil,
+			skips:    1,
+			expected: NoVideo,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			queue := NewQueue(test.items...)
+			queue.Repeat(test.repeat)
+
+			var got VideoID
+			for i := 0; i < test.skips; i++ {
+				got = queue.Skip()
+			}
+
+			if got != test.expected {
+				t.Fatalf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestQueuePrev(t *testing.T) {
+	queue := NewQueue("a", "b", "c")
+
+	queue.Skip()
+	queue.Skip()
+
+	if got := queue.Prev(); got != "a" {
+		t.Fatalf("expected %q, got %q", "a", got)
+	}
+
+	if got := queue.Prev(); got != NoVideo {
+		t.Fatalf("expected no video before the first one, got %q", got)
+	}
+}
+
+func TestQueueShuffle(t *testing.T) {
+	queue := NewQueue("a", "b", "c", "d", "e")
+	queue.Skip()
+
+	queue.Shuffle(rand.New(rand.NewSource(1)))
+
+	if queue.Current() != NoVideo {
+		t.Fatalf("the cursor was expected to reset, got %q", queue.Current())
+	}
+
+	if len(queue.items) != 5 {
+		t.Fatalf("shuffling was not expected to change the number of items, got %d", len(queue.items))
+	}
+}
+
+func TestQueueIsEmpty(t *testing.T) {
+	if !NewQueue().IsEmpty() {
+		t.Fatal("a queue with no items was expected to be empty")
+	}
+
+	if NewQueue("a").IsEmpty() {
+		t.Fatal("a queue with items was not expected to be empty")
+	}
+}
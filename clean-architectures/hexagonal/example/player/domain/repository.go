@@ -0,0 +1,17 @@
+package domain
+
+import "context"
+
+// QueueRepository persists the playback queue for a viewer.
+type QueueRepository interface {
+	FetchQueueFor(ctx context.Context, viewerID string) (*Queue, error)
+	SaveQueue(ctx context.Context, viewerID string, queue *Queue) error
+}
+
+// PreferencesRepository exposes the per-viewer settings and signals an
+// AutoplayPolicy needs once the explicit queue runs out.
+type PreferencesRepository interface {
+	IsPlayNextEnabled(ctx context.Context, viewerID string) bool
+	RelatedVideos(ctx context.Context, videoID VideoID) []VideoID
+	PersonalizedQueue(ctx context.Context, viewerID string) []VideoID
+}
@@ -1,6 +0,0 @@
-package domain
-
-type Store interface {
-	IsPlayNextEnabled() bool
-	NextAfter(VideoID) VideoID
-}
@@ -0,0 +1,98 @@
+package example
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrConflict is returned by a transport when a request conflicts with
+// server-side state, the one error WithRetry knows how to retry.
+var ErrConflict = errors.New("conflict")
+
+// Request and Response are deliberately minimal — a real client would carry
+// a path, verb and body. Here they only carry what this package's
+// middlewares need to demonstrate their behavior.
+type Request struct {
+	Header map[string]string
+	Body   string
+}
+
+type Response struct {
+	Status int
+	Body   string
+}
+
+// Doer is the seam every middleware wraps: given a request, it produces a
+// response or an error.
+type Doer func(ctx context.Context, req Request) (Response, error)
+
+// Middleware decorates a Doer with cross-cutting behavior.
+type Middleware func(next Doer) Doer
+
+func identity(next Doer) Doer {
+	return next
+}
+
+// noopTransport is the transport FirstAPI, SecondAPI and ThirdAPI fall back
+// to, since none of them ever talked to a real backend.
+func noopTransport(ctx context.Context, req Request) (Response, error) {
+	return Response{Status: 200}, nil
+}
+
+// Client is a production-ready evolution of FirstAPI/SecondAPI/ThirdAPI: a
+// functional-options constructor (like ThirdAPI) wrapping every outbound
+// call in a well-defined middleware chain.
+type Client struct {
+	clientName string
+	apiKey     string
+
+	auth      Middleware
+	logger    Middleware
+	metrics   Middleware
+	rateLimit Middleware
+	timeout   Middleware
+	retry     Middleware
+	dryRun    Middleware
+
+	transport Doer
+}
+
+// ClientOption configures a Client.
+type ClientOption func(*Client)
+
+// NewClient builds a Client around transport — the seam that actually
+// talks to the outside world — decorated by the given options.
+func NewClient(clientName string, transport Doer, opts ...ClientOption) *Client {
+	client := &Client{
+		clientName: clientName,
+		transport:  transport,
+
+		auth:      identity,
+		logger:    identity,
+		metrics:   identity,
+		rateLimit: identity,
+		timeout:   identity,
+		retry:     identity,
+		dryRun:    identity,
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
+}
+
+// Do runs req through the middleware chain and the transport. The chain
+// order is fixed regardless of the order options were passed to NewClient:
+// auth, logging and metrics wrap the outermost, observable behavior first;
+// rate limiting and timeouts bound the call; retries re-run the transport;
+// dry-run, closest to the transport, can short-circuit it entirely.
+func (c *Client) Do(ctx context.Context, req Request) (Response, error) {
+	do := c.transport
+	for _, mw := range []Middleware{c.dryRun, c.retry, c.timeout, c.rateLimit, c.metrics, c.logger, c.auth} {
+		do = mw(do)
+	}
+
+	return do(ctx, req)
+}
@@ -0,0 +1,73 @@
+package example
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestClientRetriesOnConflict(t *testing.T) {
+	attempts := 0
+	transport := func(ctx context.Context, req Request) (Response, error) {
+		attempts++
+		if attempts < 3 {
+			return Response{}, ErrConflict
+		}
+
+		return Response{Status: 200}, nil
+	}
+
+	client := NewClient("test", transport, WithRetry(func(attempt int) time.Duration {
+		return time.Millisecond
+	}))
+
+	resp, err := client.Do(context.Background(), Request{Body: "ping"})
+	if err != nil {
+		t.Fatalf("no error was expected, got %#v", err)
+	}
+	if resp.Status != 200 {
+		t.Fatalf("status 200 was expected, got %d", resp.Status)
+	}
+	if attempts != 3 {
+		t.Fatalf("3 attempts were expected, got %d", attempts)
+	}
+}
+
+func TestClientDryRunShortCircuitsTheTransport(t *testing.T) {
+	called := false
+	transport := func(ctx context.Context, req Request) (Response, error) {
+		called = true
+		return Response{Status: 200}, nil
+	}
+
+	client := NewClient("test", transport, WithDryRun())
+
+	resp, err := client.Do(context.Background(), Request{Body: "ping"})
+	if err != nil {
+		t.Fatalf("no error was expected, got %#v", err)
+	}
+	if called {
+		t.Fatalf("the transport was not expected to be called")
+	}
+	if resp.Body != "dry-run: ping" {
+		t.Fatalf("a canned dry-run response was expected, got %q", resp.Body)
+	}
+}
+
+func TestClientEnforcesATimeout(t *testing.T) {
+	transport := func(ctx context.Context, req Request) (Response, error) {
+		select {
+		case <-ctx.Done():
+			return Response{}, ctx.Err()
+		case <-time.After(50 * time.Millisecond):
+			return Response{Status: 200}, nil
+		}
+	}
+
+	client := NewClient("test", transport, WithTimeout(time.Millisecond))
+
+	_, err := client.Do(context.Background(), Request{Body: "ping"})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("a deadline exceeded error was expected, got %#v", err)
+	}
+}
@@ -1,13 +1,23 @@
 package example
 
-import "time"
+import (
+	"context"
+	"log"
+	"time"
+)
 
+// FirstAPI is kept around to illustrate the config-struct construction
+// style.
+//
+// Deprecated: use Client instead.
 type FirstAPI struct {
 	clientName     string
 	aPIKey         string
 	requestTimeout time.Duration
 	dumpDebugLogs  bool
 	isDryRun       bool
+
+	client *Client
 }
 
 type FirstAPIConfig struct {
@@ -18,6 +28,10 @@ type FirstAPIConfig struct {
 	IsDryRun       bool
 }
 
+// NewFirstAPI builds a FirstAPI, delegating every call to an internal
+// Client configured from config.
+//
+// Deprecated: use NewClient instead.
 func NewFirstAPI(config FirstAPIConfig) FirstAPI {
 	return FirstAPI{
 		clientName:     config.ClientName,
@@ -25,5 +39,34 @@ func NewFirstAPI(config FirstAPIConfig) FirstAPI {
 		requestTimeout: config.RequestTimeout,
 		dumpDebugLogs:  config.DumpDebugLogs,
 		isDryRun:       config.IsDryRun,
+
+		client: newClientFromConfig(config.ClientName, config.APIKey, config.RequestTimeout, config.DumpDebugLogs, config.IsDryRun),
+	}
+}
+
+// Do delegates to the underlying Client.
+func (api FirstAPI) Do(ctx context.Context, req Request) (Response, error) {
+	return api.client.Do(ctx, req)
+}
+
+func newClientFromConfig(clientName, apiKey string, requestTimeout time.Duration, dumpDebugLogs, isDryRun bool) *Client {
+	var opts []ClientOption
+
+	if apiKey != "" {
+		opts = append(opts, WithAuth(apiKey))
 	}
+
+	if requestTimeout > 0 {
+		opts = append(opts, WithTimeout(requestTimeout))
+	}
+
+	if dumpDebugLogs {
+		opts = append(opts, WithLogger(log.Default()))
+	}
+
+	if isDryRun {
+		opts = append(opts, WithDryRun())
+	}
+
+	return NewClient(clientName, noopTransport, opts...)
 }
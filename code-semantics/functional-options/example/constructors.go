@@ -1,22 +1,48 @@
 package example
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
+// SecondAPI is kept around to illustrate the mutator-constructor style.
+//
+// Deprecated: use Client instead.
 type SecondAPI struct {
 	clientName     string
 	aPIKey         string
 	requestTimeout time.Duration
 	dumpDebugLogs  bool
 	isDryRun       bool
+
+	client *Client
 }
 
+// NewSecondAPI builds a SecondAPI, delegating every call to an internal
+// Client.
+//
+// Deprecated: use NewClient instead.
 func NewSecondAPI(clientName, apiKey string) *SecondAPI {
-	return &SecondAPI{
+	api := &SecondAPI{
 		clientName: clientName,
 		aPIKey:     apiKey,
 	}
+
+	api.rebuildClient()
+
+	return api
 }
 
 func (api *SecondAPI) WithRequestTimeout(dur time.Duration) {
 	api.requestTimeout = dur
+	api.rebuildClient()
+}
+
+// Do delegates to the underlying Client.
+func (api *SecondAPI) Do(ctx context.Context, req Request) (Response, error) {
+	return api.client.Do(ctx, req)
+}
+
+func (api *SecondAPI) rebuildClient() {
+	api.client = newClientFromConfig(api.clientName, api.aPIKey, api.requestTimeout, api.dumpDebugLogs, api.isDryRun)
 }
@@ -0,0 +1,165 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// maxRetryAttempts bounds how many times in total, first attempt included,
+// WithRetry will run the transport for a single call.
+const maxRetryAttempts = 3
+
+// Backoff computes how long to wait before the next retry attempt, where
+// attempt is 0 on the first retry.
+type Backoff func(attempt int) time.Duration
+
+// WithRetry retries a call that fails with ErrConflict, waiting according
+// to backoff between attempts, up to maxRetryAttempts times in total.
+func WithRetry(backoff Backoff) ClientOption {
+	return func(c *Client) {
+		c.retry = func(next Doer) Doer {
+			return func(ctx context.Context, req Request) (Response, error) {
+				var resp Response
+				var err error
+
+				for attempt := 0; attempt < maxRetryAttempts; attempt++ {
+					resp, err = next(ctx, req)
+					if !errors.Is(err, ErrConflict) {
+						return resp, err
+					}
+
+					if attempt == maxRetryAttempts-1 {
+						break
+					}
+
+					select {
+					case <-ctx.Done():
+						return resp, ctx.Err()
+					case <-time.After(backoff(attempt)):
+					}
+				}
+
+				return resp, err
+			}
+		}
+	}
+}
+
+// WithTimeout bounds every call with dur, canceling the context passed
+// down to the transport once it elapses.
+func WithTimeout(dur time.Duration) ClientOption {
+	return func(c *Client) {
+		c.timeout = func(next Doer) Doer {
+			return func(ctx context.Context, req Request) (Response, error) {
+				ctx, cancel := context.WithTimeout(ctx, dur)
+				defer cancel()
+
+				return next(ctx, req)
+			}
+		}
+	}
+}
+
+// WithRateLimit spaces outbound calls at least 1/requestsPerSecond apart,
+// blocking the caller when necessary.
+func WithRateLimit(requestsPerSecond int) ClientOption {
+	interval := time.Second / time.Duration(requestsPerSecond)
+
+	return func(c *Client) {
+		var mu sync.Mutex
+		var last time.Time
+
+		c.rateLimit = func(next Doer) Doer {
+			return func(ctx context.Context, req Request) (Response, error) {
+				mu.Lock()
+				slot := time.Now()
+				if earliest := last.Add(interval); earliest.After(slot) {
+					slot = earliest
+				}
+				last = slot
+				mu.Unlock()
+
+				if wait := time.Until(slot); wait > 0 {
+					select {
+					case <-ctx.Done():
+						return Response{}, ctx.Err()
+					case <-time.After(wait):
+					}
+				}
+
+				return next(ctx, req)
+			}
+		}
+	}
+}
+
+// WithDryRun short-circuits the transport entirely, returning a canned
+// response so callers can exercise a call without any side effects.
+func WithDryRun() ClientOption {
+	return func(c *Client) {
+		c.dryRun = func(next Doer) Doer {
+			return func(ctx context.Context, req Request) (Response, error) {
+				return Response{Status: 200, Body: "dry-run: " + req.Body}, nil
+			}
+		}
+	}
+}
+
+// Logger is the minimal logging seam WithLogger wraps around.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// WithLogger logs the outcome of every call.
+func WithLogger(logger Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = func(next Doer) Doer {
+			return func(ctx context.Context, req Request) (Response, error) {
+				resp, err := next(ctx, req)
+				logger.Printf("request=%q status=%d err=%v", req.Body, resp.Status, err)
+
+				return resp, err
+			}
+		}
+	}
+}
+
+// MetricsRecorder is the minimal metrics seam WithMetrics wraps around.
+type MetricsRecorder interface {
+	RecordCall(err error)
+}
+
+// WithMetrics records the outcome of every call.
+func WithMetrics(recorder MetricsRecorder) ClientOption {
+	return func(c *Client) {
+		c.metrics = func(next Doer) Doer {
+			return func(ctx context.Context, req Request) (Response, error) {
+				resp, err := next(ctx, req)
+				recorder.RecordCall(err)
+
+				return resp, err
+			}
+		}
+	}
+}
+
+// WithAuth stamps every outbound request with an API key.
+func WithAuth(apiKey string) ClientOption {
+	return func(c *Client) {
+		c.apiKey = apiKey
+
+		c.auth = func(next Doer) Doer {
+			return func(ctx context.Context, req Request) (Response, error) {
+				if req.Header == nil {
+					req.Header = make(map[string]string, 1)
+				}
+
+				req.Header["Authorization"] = "Bearer " + apiKey
+
+				return next(ctx, req)
+			}
+		}
+	}
+}
@@ -1,17 +1,30 @@
 package example
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
+// ThirdAPI is kept around to illustrate the variadic-options construction
+// style.
+//
+// Deprecated: use Client instead.
 type ThirdAPI struct {
 	clientName     string
 	aPIKey         string
 	requestTimeout time.Duration
 	dumpDebugLogs  bool
 	isDryRun       bool
+
+	client *Client
 }
 
 type Option func(*ThirdAPI)
 
+// NewThirdAPI builds a ThirdAPI, delegating every call to an internal
+// Client configured from opts.
+//
+// Deprecated: use NewClient instead.
 func NewThirdAPI(clientName, apiKey string, opts ...Option) *ThirdAPI {
 	api := &ThirdAPI{
 		clientName: clientName,
@@ -22,9 +35,16 @@ func NewThirdAPI(clientName, apiKey string, opts ...Option) *ThirdAPI {
 		option(api)
 	}
 
+	api.client = newClientFromConfig(api.clientName, api.aPIKey, api.requestTimeout, api.dumpDebugLogs, api.isDryRun)
+
 	return api
 }
 
+// Do delegates to the underlying Client.
+func (api *ThirdAPI) Do(ctx context.Context, req Request) (Response, error) {
+	return api.client.Do(ctx, req)
+}
+
 func WithRequestTimeout(dur time.Duration) Option {
 	return func(api *ThirdAPI) {
 		api.requestTimeout = dur
@@ -36,6 +56,7 @@ func WithDebugLogsEnabled() Option {
 		api.dumpDebugLogs = true
 	}
 }
+
 func RunningOnDryRunMode() Option {
 	return func(api *ThirdAPI) {
 		api.isDryRun = true
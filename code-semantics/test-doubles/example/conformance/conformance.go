@@ -0,0 +1,112 @@
+// Package conformance provides a shared test suite any example.Store
+// implementation can run to prove it honors the store's create/get/delete
+// contract, including optimistic concurrency.
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/thisiserico/preferences/code-semantics/test-doubles/example"
+)
+
+// Tester runs the conformance suite against a freshly built Store.
+type Tester struct {
+	// NewStore returns an empty, ready-to-use Store instance.
+	NewStore func() example.Store
+}
+
+// Test runs every conformance case as a subtest of t.
+func (tester Tester) Test(t *testing.T) {
+	t.Run("creates and fetches a space", tester.testCreateAndFetch)
+	t.Run("fails to create a space that already exists", tester.testCreateAlreadyExists)
+	t.Run("fails to fetch a space that does not exist", tester.testFetchNotFound)
+	t.Run("deletes a space", tester.testDelete)
+	t.Run("fails to delete a space that does not exist", tester.testDeleteNotFound)
+	t.Run("fails to delete on a precondition mismatch", tester.testDeleteConflict)
+}
+
+func (tester Tester) testCreateAndFetch(t *testing.T) {
+	ctx := context.Background()
+	store := tester.NewStore()
+
+	space := example.NewSpace(example.SpaceInput{ID: "space-1", OwnerID: "owner-1", Name: "a space", ResourceVersion: "v1"})
+	if err := store.PutSpace(ctx, space); err != nil {
+		t.Fatalf("no error was expected creating the space, got %#v", err)
+	}
+
+	got, err := store.FetchSpaceWithID(ctx, space.ID())
+	if err != nil {
+		t.Fatalf("no error was expected fetching the space, got %#v", err)
+	}
+
+	if got.ID() != space.ID() || got.OwnerID() != space.OwnerID() {
+		t.Fatalf("the fetched space does not match the created one, got %#v", got)
+	}
+}
+
+func (tester Tester) testCreateAlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	store := tester.NewStore()
+
+	space := example.NewSpace(example.SpaceInput{ID: "space-1", OwnerID: "owner-1", Name: "a space"})
+	if err := store.PutSpace(ctx, space); err != nil {
+		t.Fatalf("no error was expected creating the space, got %#v", err)
+	}
+
+	if err := store.PutSpace(ctx, space); !example.IsAlreadyExists(err) {
+		t.Fatalf("an AlreadyExists error was expected, got %#v", err)
+	}
+}
+
+func (tester Tester) testFetchNotFound(t *testing.T) {
+	ctx := context.Background()
+	store := tester.NewStore()
+
+	if _, err := store.FetchSpaceWithID(ctx, "missing"); !example.IsNotFound(err) {
+		t.Fatalf("a NotFound error was expected, got %#v", err)
+	}
+}
+
+func (tester Tester) testDelete(t *testing.T) {
+	ctx := context.Background()
+	store := tester.NewStore()
+
+	space := example.NewSpace(example.SpaceInput{ID: "space-1", OwnerID: "owner-1", Name: "a space", ResourceVersion: "v1"})
+	if err := store.PutSpace(ctx, space); err != nil {
+		t.Fatalf("no error was expected creating the space, got %#v", err)
+	}
+
+	preconditions := example.Preconditions{UID: space.ID(), ResourceVersion: space.ResourceVersion()}
+	if err := store.RemoveSpaceWithID(ctx, space.ID(), preconditions); err != nil {
+		t.Fatalf("no error was expected removing the space, got %#v", err)
+	}
+
+	if _, err := store.FetchSpaceWithID(ctx, space.ID()); !example.IsNotFound(err) {
+		t.Fatalf("the space was expected to be gone, got %#v", err)
+	}
+}
+
+func (tester Tester) testDeleteNotFound(t *testing.T) {
+	ctx := context.Background()
+	store := tester.NewStore()
+
+	if err := store.RemoveSpaceWithID(ctx, "missing", example.Preconditions{}); !example.IsNotFound(err) {
+		t.Fatalf("a NotFound error was expected, got %#v", err)
+	}
+}
+
+func (tester Tester) testDeleteConflict(t *testing.T) {
+	ctx := context.Background()
+	store := tester.NewStore()
+
+	space := example.NewSpace(example.SpaceInput{ID: "space-1", OwnerID: "owner-1", Name: "a space", ResourceVersion: "v1"})
+	if err := store.PutSpace(ctx, space); err != nil {
+		t.Fatalf("no error was expected creating the space, got %#v", err)
+	}
+
+	preconditions := example.Preconditions{UID: space.ID(), ResourceVersion: "stale-version"}
+	if err := store.RemoveSpaceWithID(ctx, space.ID(), preconditions); !example.IsConflict(err) {
+		t.Fatalf("a Conflict error was expected, got %#v", err)
+	}
+}
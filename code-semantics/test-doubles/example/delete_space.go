@@ -1,6 +1,11 @@
 package example
 
-import "errors"
+import (
+	"context"
+	"errors"
+
+	"github.com/thisiserico/preferences/request"
+)
 
 const defaultSpaceName = "default"
 
@@ -10,26 +15,85 @@ var (
 	errNonEmptySpace           = errors.New("only empty spaces can be removed")
 	errUnremovableDefaultSpace = errors.New("the default space cannot be removed")
 	errSpaceCouldNotBeRemoved  = errors.New("the space could not be removed")
+	errSpaceConflict           = errors.New("the space was modified concurrently")
+	errForceDeleteDisallowed   = errors.New("force-deleting spaces is disallowed by policy")
+	errCascadeFailed           = errors.New("the space resources could not be removed")
 )
 
 //go:generate mockgen -self_package=github.com/thisiserico/how-to/code-semantics/test-doubles/example -package=example -destination=./double_store_test.go -mock_names=Store=StoreDouble github.com/thisiserico/how-to/code-semantics/test-doubles/example Store
+//go:generate mockgen -self_package=github.com/thisiserico/how-to/code-semantics/test-doubles/example -package=example -destination=./double_policies_test.go -mock_names=Policies=PoliciesDouble github.com/thisiserico/how-to/code-semantics/test-doubles/example Policies
+
+// Preconditions lets a caller make a write conditional on the current
+// identity of the stored resource, enabling optimistic concurrency.
+type Preconditions struct {
+	UID             string
+	ResourceVersion string
+}
 
 type Store interface {
-	FetchSpaceWithID(id string) *Space
-	RemoveSpaceWithID(id string) error
+	PutSpace(ctx context.Context, space *Space) error
+	FetchSpaceWithID(ctx context.Context, id string) (*Space, error)
+	RemoveSpaceWithID(ctx context.Context, id string, preconditions Preconditions) error
+	RemoveResourcesInSpace(ctx context.Context, id string) error
+}
+
+// Policies exposes the org-level toggles that gate otherwise-destructive
+// operations.
+type Policies interface {
+	AllowForceDeleteSpaces(ctx context.Context) bool
 }
 
 type resource struct{}
 
+// SpaceInput carries the fields needed to build a Space, mirroring how
+// config-style constructors are shaped elsewhere in this repo.
+type SpaceInput struct {
+	ID              string
+	OwnerID         string
+	Name            string
+	ResourceVersion string
+	Resources       int
+}
+
+// NewSpace builds a Space from the given input. Store implementations use
+// it to hand back data they own without exposing Space's internal fields.
+func NewSpace(in SpaceInput) *Space {
+	return &Space{
+		id:              in.ID,
+		ownerID:         in.OwnerID,
+		name:            in.Name,
+		resourceVersion: in.ResourceVersion,
+		resources:       make([]resource, in.Resources),
+	}
+}
+
 type Space struct {
-	id        string
-	ownerID   string
-	name      string
-	resources []resource
+	id              string
+	ownerID         string
+	name            string
+	resourceVersion string
+	resources       []resource
+}
+
+// ID returns the space's unique identifier.
+func (s Space) ID() string {
+	return s.id
+}
+
+// OwnerID returns the ID of the user who owns the space.
+func (s Space) OwnerID() string {
+	return s.ownerID
 }
 
-func (s Space) isOwnedBy(userID string) bool {
-	return s.ownerID == userID
+// Name returns the space's name.
+func (s Space) Name() string {
+	return s.name
+}
+
+// ResourceVersion returns the opaque version the space was stored with,
+// used as an optimistic-concurrency precondition.
+func (s Space) ResourceVersion() string {
+	return s.resourceVersion
 }
 
 func (s Space) isEmpty() bool {
@@ -40,13 +104,28 @@ func (s Space) isTheDefault() bool {
 	return s.name == defaultSpaceName
 }
 
-func DeleteSpace(store Store, id string, whoAmI string) error {
-	space := store.FetchSpaceWithID(id)
-	if space == nil {
+func (s Space) preconditions() Preconditions {
+	return Preconditions{UID: s.id, ResourceVersion: s.resourceVersion}
+}
+
+// SafeDeleteSpace removes a space while keeping every existing guardrail in
+// place: the caller must own the space, the space must be empty, and the
+// default space can never be removed. Ownership is checked against the
+// namespace carried by ctx rather than a positional caller argument.
+//
+// Deleting a space that's already gone is treated as a success, and a
+// single conflicting write is retried once against the latest version
+// before giving up.
+func SafeDeleteSpace(ctx context.Context, store Store, id string) error {
+	space, err := store.FetchSpaceWithID(ctx, id)
+	if IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
 		return errCannotFetchSpace
 	}
 
-	if !space.isOwnedBy(whoAmI) {
+	if !request.ValidNamespace(ctx, space.ownerID) {
 		return errNotAnOwnedSpace
 	}
 
@@ -58,5 +137,94 @@ func DeleteSpace(store Store, id string, whoAmI string) error {
 		return errUnremovableDefaultSpace
 	}
 
-	return store.RemoveSpaceWithID(id)
+	return removeSpaceWithRetry(ctx, store, id, space.preconditions())
+}
+
+func removeSpaceWithRetry(ctx context.Context, store Store, id string, preconditions Preconditions) error {
+	err := store.RemoveSpaceWithID(ctx, id, preconditions)
+	if err == nil || IsNotFound(err) {
+		return nil
+	}
+
+	if !IsConflict(err) {
+		return errSpaceCouldNotBeRemoved
+	}
+
+	space, fetchErr := store.FetchSpaceWithID(ctx, id)
+	if IsNotFound(fetchErr) {
+		return nil
+	}
+	if fetchErr != nil {
+		return errCannotFetchSpace
+	}
+
+	err = store.RemoveSpaceWithID(ctx, id, space.preconditions())
+	switch {
+	case err == nil || IsNotFound(err):
+		return nil
+	case IsConflict(err):
+		return errSpaceConflict
+	default:
+		return errSpaceCouldNotBeRemoved
+	}
+}
+
+// ForceDeleteOption customizes how ForceDeleteSpace reacts to a non-empty
+// space.
+type ForceDeleteOption func(*forceDeleteConfig)
+
+type forceDeleteConfig struct {
+	cascade bool
+}
+
+// WithCascade lets ForceDeleteSpace remove a non-empty space by first
+// removing every resource it contains.
+func WithCascade() ForceDeleteOption {
+	return func(cfg *forceDeleteConfig) {
+		cfg.cascade = true
+	}
+}
+
+// ForceDeleteSpace removes a space, provided the given policies allow
+// force-deletion. With WithCascade it overrides the empty-space guardrail
+// by first removing the space's resources; without it a non-empty space is
+// still rejected. The default space still can't be removed, and ownership is
+// still checked against the namespace carried by ctx.
+func ForceDeleteSpace(ctx context.Context, store Store, policies Policies, id string, opts ...ForceDeleteOption) error {
+	if !policies.AllowForceDeleteSpaces(ctx) {
+		return errForceDeleteDisallowed
+	}
+
+	var cfg forceDeleteConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	space, err := store.FetchSpaceWithID(ctx, id)
+	if IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errCannotFetchSpace
+	}
+
+	if !request.ValidNamespace(ctx, space.ownerID) {
+		return errNotAnOwnedSpace
+	}
+
+	if space.isTheDefault() {
+		return errUnremovableDefaultSpace
+	}
+
+	if !space.isEmpty() {
+		if !cfg.cascade {
+			return errNonEmptySpace
+		}
+
+		if err := store.RemoveResourcesInSpace(ctx, id); err != nil {
+			return errCascadeFailed
+		}
+	}
+
+	return removeSpaceWithRetry(ctx, store, id, space.preconditions())
 }
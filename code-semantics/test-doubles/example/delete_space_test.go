@@ -1,14 +1,26 @@
 package example
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+
+	"github.com/thisiserico/preferences/request"
 )
 
-func TestDeleteSpace(t *testing.T) {
+func TestSafeDeleteSpace(t *testing.T) {
+	t.Run("does not error when the space is already gone", func(t *testing.T) {
+		test := setupTestSafeDeleteSpace(t)
+		t.Cleanup(test.cleanup)
+
+		test.givenASpaceThatCannotBeFound()
+		test.whenDeletingTheSpace()
+		test.thenNoErrorsAreReturned()
+	})
+
 	t.Run("errors when the space cannot be fetched", func(t *testing.T) {
-		test := setupTestDeleteSpace(t)
+		test := setupTestSafeDeleteSpace(t)
 		t.Cleanup(test.cleanup)
 
 		test.givenASpaceThatCannotBeFetched()
@@ -16,8 +28,8 @@ func TestDeleteSpace(t *testing.T) {
 		test.thenACannotFetchSpaceErrorIsReturned()
 	})
 
-	t.Run("errors when the space is not owned by the user removing it", func(t *testing.T) {
-		test := setupTestDeleteSpace(t)
+	t.Run("errors when the caller's namespace does not match the space owner", func(t *testing.T) {
+		test := setupTestSafeDeleteSpace(t)
 		t.Cleanup(test.cleanup)
 
 		test.givenAUserThatDoesNotOwnASpace()
@@ -26,7 +38,7 @@ func TestDeleteSpace(t *testing.T) {
 	})
 
 	t.Run("errors when the space is not empty", func(t *testing.T) {
-		test := setupTestDeleteSpace(t)
+		test := setupTestSafeDeleteSpace(t)
 		t.Cleanup(test.cleanup)
 
 		test.givenASpaceThatIsNotEmpty()
@@ -35,7 +47,7 @@ func TestDeleteSpace(t *testing.T) {
 	})
 
 	t.Run("errors when removing the default space", func(t *testing.T) {
-		test := setupTestDeleteSpace(t)
+		test := setupTestSafeDeleteSpace(t)
 		t.Cleanup(test.cleanup)
 
 		test.givenTheDefaultSpaceOfAUser()
@@ -44,7 +56,7 @@ func TestDeleteSpace(t *testing.T) {
 	})
 
 	t.Run("errors when the space cannot be removed", func(t *testing.T) {
-		test := setupTestDeleteSpace(t)
+		test := setupTestSafeDeleteSpace(t)
 		t.Cleanup(test.cleanup)
 
 		test.givenASpaceThatCannotBeRemoved()
@@ -52,8 +64,26 @@ func TestDeleteSpace(t *testing.T) {
 		test.thenASpaceCouldNotBeRemovedErrorIsReturned()
 	})
 
+	t.Run("retries once on a transient conflict and succeeds", func(t *testing.T) {
+		test := setupTestSafeDeleteSpace(t)
+		t.Cleanup(test.cleanup)
+
+		test.givenARemovalThatConflictsOnce()
+		test.whenDeletingTheSpace()
+		test.thenNoErrorsAreReturned()
+	})
+
+	t.Run("errors when the conflict persists after the retry", func(t *testing.T) {
+		test := setupTestSafeDeleteSpace(t)
+		t.Cleanup(test.cleanup)
+
+		test.givenARemovalThatAlwaysConflicts()
+		test.whenDeletingTheSpace()
+		test.thenASpaceConflictErrorIsReturned()
+	})
+
 	t.Run("does not error on success", func(t *testing.T) {
-		test := setupTestDeleteSpace(t)
+		test := setupTestSafeDeleteSpace(t)
 		t.Cleanup(test.cleanup)
 
 		test.whenDeletingTheSpace()
@@ -61,134 +91,171 @@ func TestDeleteSpace(t *testing.T) {
 	})
 }
 
-type testDeleteSpace struct {
+type testSafeDeleteSpace struct {
 	*testing.T
 
 	ctrl  *gomock.Controller
 	store *StoreDouble
 
+	ctx     context.Context
 	spaceID string
 	ownerID string
 
 	returnedErr error
 }
 
-func setupTestDeleteSpace(t *testing.T) *testDeleteSpace {
+func setupTestSafeDeleteSpace(t *testing.T) *testSafeDeleteSpace {
 	ctrl := gomock.NewController(t)
 
-	return &testDeleteSpace{
+	ownerID := "known-owner"
+
+	return &testSafeDeleteSpace{
 		T: t,
 
 		ctrl:  ctrl,
 		store: NewStoreDouble(ctrl),
 
+		ctx:     request.WithNamespace(context.Background(), ownerID),
 		spaceID: "known-space",
-		ownerID: "known-owner",
+		ownerID: ownerID,
 	}
 }
 
-func (t *testDeleteSpace) cleanup() {
+func (t *testSafeDeleteSpace) cleanup() {
 	t.ctrl.Finish()
 }
 
-func (t *testDeleteSpace) givenASpaceThatCannotBeFetched() {
+func (t *testSafeDeleteSpace) givenASpaceThatCannotBeFound() {
 	t.store.
 		EXPECT().
-		FetchSpaceWithID(gomock.Any()).
-		Return(nil)
+		FetchSpaceWithID(gomock.Any(), gomock.Any()).
+		Return(nil, NewStoreError(KindNotFound, "FetchSpaceWithID", errCannotFetchSpace))
 }
 
-func (t *testDeleteSpace) givenAUserThatDoesNotOwnASpace() {
+func (t *testSafeDeleteSpace) givenASpaceThatCannotBeFetched() {
 	t.store.
 		EXPECT().
-		FetchSpaceWithID(gomock.Any()).
+		FetchSpaceWithID(gomock.Any(), gomock.Any()).
+		Return(nil, errCannotFetchSpace)
+}
+
+func (t *testSafeDeleteSpace) givenAUserThatDoesNotOwnASpace() {
+	t.store.
+		EXPECT().
+		FetchSpaceWithID(gomock.Any(), gomock.Any()).
 		Return(&Space{
 			id:      t.spaceID,
 			ownerID: "unknown-owner",
 			name:    "not a default space",
-		})
+		}, nil)
 }
 
-func (t *testDeleteSpace) givenASpaceThatIsNotEmpty() {
+func (t *testSafeDeleteSpace) givenASpaceThatIsNotEmpty() {
 	t.store.
 		EXPECT().
-		FetchSpaceWithID(gomock.Any()).
+		FetchSpaceWithID(gomock.Any(), gomock.Any()).
 		Return(&Space{
 			id:        t.spaceID,
 			ownerID:   t.ownerID,
 			name:      "not a default space",
 			resources: []resource{resource{}},
-		})
+		}, nil)
 }
 
-func (t *testDeleteSpace) givenTheDefaultSpaceOfAUser() {
+func (t *testSafeDeleteSpace) givenTheDefaultSpaceOfAUser() {
 	t.store.
 		EXPECT().
-		FetchSpaceWithID(gomock.Any()).
+		FetchSpaceWithID(gomock.Any(), gomock.Any()).
 		Return(&Space{
 			id:      t.spaceID,
 			ownerID: t.ownerID,
 			name:    "default",
-		})
+		}, nil)
 }
 
-func (t *testDeleteSpace) givenASpaceThatCannotBeRemoved() {
+func (t *testSafeDeleteSpace) givenASpaceThatCannotBeRemoved() {
 	t.store.
 		EXPECT().
-		RemoveSpaceWithID(gomock.Any()).
+		RemoveSpaceWithID(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(errSpaceCouldNotBeRemoved)
 }
 
-func (t *testDeleteSpace) whenDeletingTheSpace() {
+func (t *testSafeDeleteSpace) givenARemovalThatConflictsOnce() {
+	conflict := NewStoreError(KindConflict, "RemoveSpaceWithID", errSpaceConflict)
+
+	gomock.InOrder(
+		t.store.EXPECT().RemoveSpaceWithID(t.ctx, t.spaceID, gomock.Any()).Return(conflict),
+		t.store.EXPECT().RemoveSpaceWithID(t.ctx, t.spaceID, gomock.Any()).Return(nil),
+	)
+}
+
+func (t *testSafeDeleteSpace) givenARemovalThatAlwaysConflicts() {
+	conflict := NewStoreError(KindConflict, "RemoveSpaceWithID", errSpaceConflict)
+
+	t.store.
+		EXPECT().
+		RemoveSpaceWithID(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(conflict).
+		Times(2)
+}
+
+func (t *testSafeDeleteSpace) whenDeletingTheSpace() {
 	t.store.
 		EXPECT().
-		FetchSpaceWithID(t.spaceID).
+		FetchSpaceWithID(t.ctx, t.spaceID).
 		Return(&Space{
 			id:      t.spaceID,
 			ownerID: t.ownerID,
 			name:    "not a default space",
-		}).
+		}, nil).
 		AnyTimes()
 
 	t.store.
 		EXPECT().
-		RemoveSpaceWithID(t.spaceID).
+		RemoveSpaceWithID(t.ctx, t.spaceID, gomock.Any()).
+		Return(nil).
 		AnyTimes()
 
-	t.returnedErr = DeleteSpace(t.store, t.spaceID, t.ownerID)
+	t.returnedErr = SafeDeleteSpace(t.ctx, t.store, t.spaceID)
 }
 
-func (t *testDeleteSpace) thenACannotFetchSpaceErrorIsReturned() {
+func (t *testSafeDeleteSpace) thenACannotFetchSpaceErrorIsReturned() {
 	if t.returnedErr != errCannotFetchSpace {
 		t.Fatalf("an error is expected when a space cannot be fetched, got %#v", t.returnedErr)
 	}
 }
 
-func (t *testDeleteSpace) thenANotAnOwnedSpaceErrorIsReturned() {
+func (t *testSafeDeleteSpace) thenANotAnOwnedSpaceErrorIsReturned() {
 	if t.returnedErr != errNotAnOwnedSpace {
 		t.Fatalf("an error is expected when the space is not owned by the user removing it, got %#v", t.returnedErr)
 	}
 }
 
-func (t *testDeleteSpace) thenANonEmptySpaceErrorIsReturned() {
+func (t *testSafeDeleteSpace) thenANonEmptySpaceErrorIsReturned() {
 	if t.returnedErr != errNonEmptySpace {
 		t.Fatalf("an error is expected when the space is not empty, got %#v", t.returnedErr)
 	}
 }
 
-func (t *testDeleteSpace) thenAnUnremovableDefaultSpaceErrorIsReturned() {
+func (t *testSafeDeleteSpace) thenAnUnremovableDefaultSpaceErrorIsReturned() {
 	if t.returnedErr != errUnremovableDefaultSpace {
 		t.Fatalf("an error is expected when removing the default space, got %#v", t.returnedErr)
 	}
 }
 
-func (t *testDeleteSpace) thenASpaceCouldNotBeRemovedErrorIsReturned() {
+func (t *testSafeDeleteSpace) thenASpaceCouldNotBeRemovedErrorIsReturned() {
 	if t.returnedErr != errSpaceCouldNotBeRemoved {
 		t.Fatalf("an error is expected when a space cannot be removed, got %#v", t.returnedErr)
 	}
 }
 
-func (t *testDeleteSpace) thenNoErrorsAreReturned() {
+func (t *testSafeDeleteSpace) thenASpaceConflictErrorIsReturned() {
+	if t.returnedErr != errSpaceConflict {
+		t.Fatalf("a conflict error is expected when the retry still conflicts, got %#v", t.returnedErr)
+	}
+}
+
+func (t *testSafeDeleteSpace) thenNoErrorsAreReturned() {
 	if t.returnedErr != nil {
 		t.Fatalf("no errors were expected, got %#v", t.returnedErr)
 	}
@@ -0,0 +1,69 @@
+package example
+
+import "errors"
+
+// ErrorKind categorizes the way a Store operation failed, mirroring the
+// conventions used by REST-ish storage APIs: NotFound, AlreadyExists,
+// Conflict and Invalid.
+type ErrorKind string
+
+const (
+	KindNotFound      ErrorKind = "NotFound"
+	KindAlreadyExists ErrorKind = "AlreadyExists"
+	KindConflict      ErrorKind = "Conflict"
+	KindInvalid       ErrorKind = "Invalid"
+)
+
+// StoreError is returned by Store implementations so callers can branch on
+// Kind instead of matching against a fixed set of sentinel errors.
+type StoreError struct {
+	Kind ErrorKind
+	Op   string
+	Err  error
+}
+
+// NewStoreError builds a StoreError for the given operation and kind.
+func NewStoreError(kind ErrorKind, op string, err error) *StoreError {
+	return &StoreError{Kind: kind, Op: op, Err: err}
+}
+
+func (e *StoreError) Error() string {
+	return e.Op + ": " + e.Err.Error()
+}
+
+func (e *StoreError) Unwrap() error {
+	return e.Err
+}
+
+func kindOf(err error) (ErrorKind, bool) {
+	var storeErr *StoreError
+	if errors.As(err, &storeErr) {
+		return storeErr.Kind, true
+	}
+
+	return "", false
+}
+
+// IsNotFound reports whether err is a StoreError of kind NotFound.
+func IsNotFound(err error) bool {
+	kind, ok := kindOf(err)
+	return ok && kind == KindNotFound
+}
+
+// IsAlreadyExists reports whether err is a StoreError of kind AlreadyExists.
+func IsAlreadyExists(err error) bool {
+	kind, ok := kindOf(err)
+	return ok && kind == KindAlreadyExists
+}
+
+// IsConflict reports whether err is a StoreError of kind Conflict.
+func IsConflict(err error) bool {
+	kind, ok := kindOf(err)
+	return ok && kind == KindConflict
+}
+
+// IsInvalid reports whether err is a StoreError of kind Invalid.
+func IsInvalid(err error) bool {
+	kind, ok := kindOf(err)
+	return ok && kind == KindInvalid
+}
@@ -0,0 +1,258 @@
+package example
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/thisiserico/preferences/request"
+)
+
+func TestForceDeleteSpace(t *testing.T) {
+	t.Run("errors when the policy disallows force-deletion", func(t *testing.T) {
+		test := setupTestForceDeleteSpace(t)
+		t.Cleanup(test.cleanup)
+
+		test.givenAPolicyThatDisallowsForceDeletion()
+		test.whenForceDeletingTheSpace()
+		test.thenAForceDeleteDisallowedErrorIsReturned()
+	})
+
+	t.Run("errors when the space cannot be fetched", func(t *testing.T) {
+		test := setupTestForceDeleteSpace(t)
+		t.Cleanup(test.cleanup)
+
+		test.givenASpaceThatCannotBeFetched()
+		test.whenForceDeletingTheSpace()
+		test.thenACannotFetchSpaceErrorIsReturned()
+	})
+
+	t.Run("errors when the caller's namespace does not match the space owner", func(t *testing.T) {
+		test := setupTestForceDeleteSpace(t)
+		t.Cleanup(test.cleanup)
+
+		test.givenAUserThatDoesNotOwnASpace()
+		test.whenForceDeletingTheSpace()
+		test.thenANotAnOwnedSpaceErrorIsReturned()
+	})
+
+	t.Run("errors when removing the default space", func(t *testing.T) {
+		test := setupTestForceDeleteSpace(t)
+		t.Cleanup(test.cleanup)
+
+		test.givenTheDefaultSpaceOfAUser()
+		test.whenForceDeletingTheSpace()
+		test.thenAnUnremovableDefaultSpaceErrorIsReturned()
+	})
+
+	t.Run("errors when the space is not empty and cascading was not requested", func(t *testing.T) {
+		test := setupTestForceDeleteSpace(t)
+		t.Cleanup(test.cleanup)
+
+		test.givenASpaceThatIsNotEmpty()
+		test.whenForceDeletingTheSpace()
+		test.thenANonEmptySpaceErrorIsReturned()
+	})
+
+	t.Run("errors when the cascading resource removal fails", func(t *testing.T) {
+		test := setupTestForceDeleteSpace(t)
+		t.Cleanup(test.cleanup)
+
+		test.givenASpaceThatIsNotEmpty()
+		test.givenResourcesThatCannotBeRemoved()
+		test.whenForceDeletingTheSpaceWithCascade()
+		test.thenACascadeFailedErrorIsReturned()
+	})
+
+	t.Run("does not error when cascading removes a non-empty space", func(t *testing.T) {
+		test := setupTestForceDeleteSpace(t)
+		t.Cleanup(test.cleanup)
+
+		test.givenASpaceThatIsNotEmpty()
+		test.whenForceDeletingTheSpaceWithCascade()
+		test.thenNoErrorsAreReturned()
+	})
+
+	t.Run("does not error when the space is already gone", func(t *testing.T) {
+		test := setupTestForceDeleteSpace(t)
+		t.Cleanup(test.cleanup)
+
+		test.givenASpaceThatCannotBeFound()
+		test.whenForceDeletingTheSpace()
+		test.thenNoErrorsAreReturned()
+	})
+}
+
+type testForceDeleteSpace struct {
+	*testing.T
+
+	ctrl     *gomock.Controller
+	store    *StoreDouble
+	policies *PoliciesDouble
+
+	ctx     context.Context
+	spaceID string
+	ownerID string
+
+	returnedErr error
+}
+
+func setupTestForceDeleteSpace(t *testing.T) *testForceDeleteSpace {
+	ctrl := gomock.NewController(t)
+
+	ownerID := "known-owner"
+
+	test := &testForceDeleteSpace{
+		T: t,
+
+		ctrl:     ctrl,
+		store:    NewStoreDouble(ctrl),
+		policies: NewPoliciesDouble(ctrl),
+
+		ctx:     request.WithNamespace(context.Background(), ownerID),
+		spaceID: "known-space",
+		ownerID: ownerID,
+	}
+
+	test.policies.
+		EXPECT().
+		AllowForceDeleteSpaces(gomock.Any()).
+		Return(true).
+		AnyTimes()
+
+	return test
+}
+
+func (t *testForceDeleteSpace) cleanup() {
+	t.ctrl.Finish()
+}
+
+func (t *testForceDeleteSpace) givenAPolicyThatDisallowsForceDeletion() {
+	t.policies = NewPoliciesDouble(t.ctrl)
+	t.policies.
+		EXPECT().
+		AllowForceDeleteSpaces(gomock.Any()).
+		Return(false)
+}
+
+func (t *testForceDeleteSpace) givenASpaceThatCannotBeFound() {
+	t.store.
+		EXPECT().
+		FetchSpaceWithID(gomock.Any(), gomock.Any()).
+		Return(nil, NewStoreError(KindNotFound, "FetchSpaceWithID", errCannotFetchSpace))
+}
+
+func (t *testForceDeleteSpace) givenASpaceThatCannotBeFetched() {
+	t.store.
+		EXPECT().
+		FetchSpaceWithID(gomock.Any(), gomock.Any()).
+		Return(nil, errCannotFetchSpace)
+}
+
+func (t *testForceDeleteSpace) givenAUserThatDoesNotOwnASpace() {
+	t.store.
+		EXPECT().
+		FetchSpaceWithID(gomock.Any(), gomock.Any()).
+		Return(&Space{
+			id:      t.spaceID,
+			ownerID: "unknown-owner",
+			name:    "not a default space",
+		}, nil)
+}
+
+func (t *testForceDeleteSpace) givenTheDefaultSpaceOfAUser() {
+	t.store.
+		EXPECT().
+		FetchSpaceWithID(gomock.Any(), gomock.Any()).
+		Return(&Space{
+			id:      t.spaceID,
+			ownerID: t.ownerID,
+			name:    "default",
+		}, nil)
+}
+
+func (t *testForceDeleteSpace) givenASpaceThatIsNotEmpty() {
+	t.store.
+		EXPECT().
+		FetchSpaceWithID(gomock.Any(), gomock.Any()).
+		Return(&Space{
+			id:        t.spaceID,
+			ownerID:   t.ownerID,
+			name:      "not a default space",
+			resources: []resource{resource{}},
+		}, nil)
+}
+
+func (t *testForceDeleteSpace) givenResourcesThatCannotBeRemoved() {
+	t.store.
+		EXPECT().
+		RemoveResourcesInSpace(gomock.Any(), gomock.Any()).
+		Return(errCascadeFailed)
+}
+
+func (t *testForceDeleteSpace) whenForceDeletingTheSpace() {
+	t.returnedErr = t.forceDelete()
+}
+
+func (t *testForceDeleteSpace) whenForceDeletingTheSpaceWithCascade() {
+	t.store.
+		EXPECT().
+		RemoveResourcesInSpace(t.ctx, t.spaceID).
+		Return(nil).
+		AnyTimes()
+
+	t.returnedErr = t.forceDelete(WithCascade())
+}
+
+func (t *testForceDeleteSpace) forceDelete(opts ...ForceDeleteOption) error {
+	t.store.
+		EXPECT().
+		RemoveSpaceWithID(t.ctx, t.spaceID, gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	return ForceDeleteSpace(t.ctx, t.store, t.policies, t.spaceID, opts...)
+}
+
+func (t *testForceDeleteSpace) thenAForceDeleteDisallowedErrorIsReturned() {
+	if t.returnedErr != errForceDeleteDisallowed {
+		t.Fatalf("an error is expected when force-deletion is disallowed, got %#v", t.returnedErr)
+	}
+}
+
+func (t *testForceDeleteSpace) thenACannotFetchSpaceErrorIsReturned() {
+	if t.returnedErr != errCannotFetchSpace {
+		t.Fatalf("an error is expected when a space cannot be fetched, got %#v", t.returnedErr)
+	}
+}
+
+func (t *testForceDeleteSpace) thenANotAnOwnedSpaceErrorIsReturned() {
+	if t.returnedErr != errNotAnOwnedSpace {
+		t.Fatalf("an error is expected when the space is not owned by the user removing it, got %#v", t.returnedErr)
+	}
+}
+
+func (t *testForceDeleteSpace) thenAnUnremovableDefaultSpaceErrorIsReturned() {
+	if t.returnedErr != errUnremovableDefaultSpace {
+		t.Fatalf("an error is expected when removing the default space, got %#v", t.returnedErr)
+	}
+}
+
+func (t *testForceDeleteSpace) thenANonEmptySpaceErrorIsReturned() {
+	if t.returnedErr != errNonEmptySpace {
+		t.Fatalf("an error is expected when the space is not empty and cascading was not requested, got %#v", t.returnedErr)
+	}
+}
+
+func (t *testForceDeleteSpace) thenACascadeFailedErrorIsReturned() {
+	if t.returnedErr != errCascadeFailed {
+		t.Fatalf("an error is expected when the cascading resource removal fails, got %#v", t.returnedErr)
+	}
+}
+
+func (t *testForceDeleteSpace) thenNoErrorsAreReturned() {
+	if t.returnedErr != nil {
+		t.Fatalf("no errors were expected, got %#v", t.returnedErr)
+	}
+}
@@ -0,0 +1,98 @@
+// Package memstore provides an in-memory reference implementation of
+// example.Store, enforcing the same create/get/delete semantics a real
+// storage backend would: not-found on missing resources, already-exists on
+// duplicate creation, and optimistic-concurrency conflicts on stale
+// preconditions.
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/thisiserico/preferences/code-semantics/test-doubles/example"
+)
+
+// Store is an in-memory example.Store.
+type Store struct {
+	mu     sync.Mutex
+	spaces map[string]*example.Space
+}
+
+// New returns an empty Store.
+func New() *Store {
+	return &Store{spaces: make(map[string]*example.Space)}
+}
+
+func (s *Store) PutSpace(ctx context.Context, space *example.Space) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if space.ID() == "" {
+		return example.NewStoreError(example.KindInvalid, "PutSpace", fmt.Errorf("a space ID is required"))
+	}
+
+	if _, exists := s.spaces[space.ID()]; exists {
+		return example.NewStoreError(example.KindAlreadyExists, "PutSpace", fmt.Errorf("space %q already exists", space.ID()))
+	}
+
+	stored := *space
+	s.spaces[stored.ID()] = &stored
+
+	return nil
+}
+
+func (s *Store) FetchSpaceWithID(ctx context.Context, id string) (*example.Space, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	space, ok := s.spaces[id]
+	if !ok {
+		return nil, example.NewStoreError(example.KindNotFound, "FetchSpaceWithID", fmt.Errorf("space %q not found", id))
+	}
+
+	copied := *space
+
+	return &copied, nil
+}
+
+func (s *Store) RemoveSpaceWithID(ctx context.Context, id string, preconditions example.Preconditions) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	space, ok := s.spaces[id]
+	if !ok {
+		return example.NewStoreError(example.KindNotFound, "RemoveSpaceWithID", fmt.Errorf("space %q not found", id))
+	}
+
+	if preconditions.UID != "" && preconditions.UID != space.ID() {
+		return example.NewStoreError(example.KindConflict, "RemoveSpaceWithID", fmt.Errorf("UID precondition mismatch for space %q", id))
+	}
+
+	if preconditions.ResourceVersion != "" && preconditions.ResourceVersion != space.ResourceVersion() {
+		return example.NewStoreError(example.KindConflict, "RemoveSpaceWithID", fmt.Errorf("resourceVersion precondition mismatch for space %q", id))
+	}
+
+	delete(s.spaces, id)
+
+	return nil
+}
+
+func (s *Store) RemoveResourcesInSpace(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	space, ok := s.spaces[id]
+	if !ok {
+		return example.NewStoreError(example.KindNotFound, "RemoveResourcesInSpace", fmt.Errorf("space %q not found", id))
+	}
+
+	s.spaces[id] = example.NewSpace(example.SpaceInput{
+		ID:              space.ID(),
+		OwnerID:         space.OwnerID(),
+		Name:            space.Name(),
+		ResourceVersion: space.ResourceVersion(),
+	})
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package memstore_test
+
+import (
+	"testing"
+
+	"github.com/thisiserico/preferences/code-semantics/test-doubles/example"
+	"github.com/thisiserico/preferences/code-semantics/test-doubles/example/conformance"
+	"github.com/thisiserico/preferences/code-semantics/test-doubles/example/memstore"
+)
+
+func TestStore(t *testing.T) {
+	tester := conformance.Tester{
+		NewStore: func() example.Store {
+			return memstore.New()
+		},
+	}
+
+	tester.Test(t)
+}
@@ -0,0 +1,56 @@
+// Package request carries the per-call metadata that application layers
+// need to scope and authorize work against a context.Context, the same way
+// Kubernetes threads a namespace through ctx.
+package request
+
+import (
+	"context"
+	"time"
+)
+
+type contextKey int
+
+const (
+	namespaceKey contextKey = iota
+	identityKey
+)
+
+// WithNamespace returns a copy of ctx carrying the given tenant/namespace.
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceKey, namespace)
+}
+
+// NamespaceFrom returns the namespace carried by ctx, if any.
+func NamespaceFrom(ctx context.Context) (string, bool) {
+	namespace, ok := ctx.Value(namespaceKey).(string)
+	return namespace, ok
+}
+
+// WithIdentity returns a copy of ctx carrying the identity of the caller
+// making the request.
+func WithIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, identityKey, identity)
+}
+
+// IdentityFrom returns the caller identity carried by ctx, if any.
+func IdentityFrom(ctx context.Context) (string, bool) {
+	identity, ok := ctx.Value(identityKey).(string)
+	return identity, ok
+}
+
+// DeadlineFrom returns the deadline carried by ctx, if any.
+func DeadlineFrom(ctx context.Context) (time.Time, bool) {
+	return ctx.Deadline()
+}
+
+// ValidNamespace reports whether owner matches the namespace carried by
+// ctx. Use-cases call this instead of comparing a positional identity
+// argument against a resource's owner.
+func ValidNamespace(ctx context.Context, owner string) bool {
+	namespace, ok := NamespaceFrom(ctx)
+	if !ok {
+		return false
+	}
+
+	return namespace == owner
+}
@@ -0,0 +1,42 @@
+package request
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestValidNamespace(t *testing.T) {
+	t.Run("is valid when the namespace matches the owner", func(t *testing.T) {
+		ctx := WithNamespace(context.Background(), "known-owner")
+
+		if !ValidNamespace(ctx, "known-owner") {
+			t.Fatal("the namespace was expected to be valid")
+		}
+	})
+
+	t.Run("is invalid when the namespace does not match the owner", func(t *testing.T) {
+		ctx := WithNamespace(context.Background(), "known-owner")
+
+		if ValidNamespace(ctx, "someone-else") {
+			t.Fatal("the namespace was not expected to be valid")
+		}
+	})
+
+	t.Run("is invalid when no namespace was set", func(t *testing.T) {
+		if ValidNamespace(context.Background(), "known-owner") {
+			t.Fatal("the namespace was not expected to be valid")
+		}
+	})
+}
+
+func TestDeadlineFrom(t *testing.T) {
+	deadline := time.Now().Add(time.Minute)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	got, ok := DeadlineFrom(ctx)
+	if !ok || !got.Equal(deadline) {
+		t.Fatalf("the deadline was expected to be carried over, got %v", got)
+	}
+}